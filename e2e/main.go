@@ -0,0 +1,29 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/Azure/agentbaker/e2e/scenario"
+)
+
+func main() {
+	selectorExpr := flag.String("l", "", "label/tag selector expression, e.g. 'os=ubuntu2204,gpu'")
+	scenarioPlugins := flag.String("scenario-plugins", "", "glob of scenario plugin .so files to load, e.g. /path/to/*.so")
+	flag.Parse()
+
+	if *scenarioPlugins != "" {
+		os.Setenv(scenario.PluginDirEnvVar, *scenarioPlugins)
+	}
+
+	selector, err := scenario.ParseSelector(*selectorExpr)
+	if err != nil {
+		log.Fatalf("invalid -l selector: %v", err)
+	}
+
+	table := scenario.InitScenarioTableWithSelector(nil, selector)
+	for name := range table {
+		log.Printf("will run E2E scenario %q", name)
+	}
+}
@@ -2,8 +2,13 @@ package scenario
 
 import (
 	"log"
+	"os"
 )
 
+// scenarioDirEnvVar points LoadScenariosFromDir at a directory of YAML scenario definitions
+// that supplement the hardcoded scenarios below. Unset by default.
+const scenarioDirEnvVar = "AGENTBAKER_E2E_SCENARIO_DIR"
+
 // Initializes and returns the set of scenarios comprising the E2E suite in table-form.
 func InitScenarioTable(scenariosToRun map[string]bool) ScenarioTable {
 	table := ScenarioTable{}
@@ -16,11 +21,19 @@ func InitScenarioTable(scenariosToRun map[string]bool) ScenarioTable {
 	return table
 }
 
+// InitScenarioTableWithSelector behaves like InitScenarioTable, then further filters the
+// result down to scenarios matching selector. Used by the e2e CLI's `-l` flag.
+func InitScenarioTableWithSelector(scenariosToRun map[string]bool, selector Selector) ScenarioTable {
+	return InitScenarioTable(scenariosToRun).Filter(selector)
+}
+
 // Is called internally by the scenario package to get each scenario's respective config as one long slice.
 // To add a sceneario, implement a new function in a separate file that returns a *Scenario and add
-// its return value to the slice returned by this function.
+// its return value to the slice returned by this function. Also appends scenarios loaded from
+// scenarioDirEnvVar (YAML, see LoadScenariosFromDir) and PluginDirEnvVar (compiled plugins, see
+// loadPlugins) when those environment variables are set.
 func scenarios() []*Scenario {
-	return []*Scenario{
+	all := []*Scenario{
 		base(),
 		ubuntu2204(),
 		marinerv1(),
@@ -29,4 +42,24 @@ func scenarios() []*Scenario {
 		marinerv2ARM64(),
 		gpu(),
 	}
-}
\ No newline at end of file
+
+	if dir := os.Getenv(scenarioDirEnvVar); dir != "" {
+		fromDisk, err := LoadScenariosFromDir(os.DirFS(dir), ".", nil)
+		if err != nil {
+			log.Printf("failed to load scenarios from %q: %v", dir, err)
+		} else {
+			all = append(all, fromDisk...)
+		}
+	}
+
+	if pattern := os.Getenv(PluginDirEnvVar); pattern != "" {
+		fromPlugins, err := loadPlugins(pattern)
+		if err != nil {
+			log.Printf("failed to load scenario plugins %q: %v", pattern, err)
+		} else {
+			all = append(all, fromPlugins...)
+		}
+	}
+
+	return all
+}
@@ -0,0 +1,138 @@
+package scenario
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+// templateData is the set of values exposed to a scenario YAML file's Go templates,
+// e.g. {{ .Vars.subscription }} or {{ .Env.AZURE_LOCATION }}.
+type templateData struct {
+	Vars map[string]string
+	Env  map[string]string
+}
+
+// scenarioFile is the on-disk shape of a scenario definition, before template rendering.
+type scenarioFile struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+
+	Labels map[string]string `yaml:"labels"`
+	Tags   []string          `yaml:"tags"`
+
+	Distro string `yaml:"distro"`
+	Arch   string `yaml:"arch"`
+	VHD    string `yaml:"vhd"`
+
+	BootstrapConfigOverrides map[string]interface{} `yaml:"bootstrapConfigOverrides"`
+
+	ValidationSteps []struct {
+		Description         string `yaml:"description"`
+		Command             string `yaml:"command"`
+		ExpectedExitCode    int    `yaml:"expectedExitCode"`
+		ExpectedStdoutRegex string `yaml:"expectedStdoutRegex"`
+	} `yaml:"validationSteps"`
+}
+
+// LoadScenariosFromDir reads every *.yaml/*.yml file directly in dir in fsys (subdirectories
+// are not searched), renders it as a Go
+// template (fields .Vars and .Env, the latter populated from the process environment), and
+// parses the result into a *Scenario.
+func LoadScenariosFromDir(fsys fs.FS, dir string, vars map[string]string) ([]*Scenario, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading scenario dir %q: %w", dir, err)
+	}
+
+	data := templateData{
+		Vars: vars,
+		Env:  environAsMap(),
+	}
+
+	var out []*Scenario
+	for _, entry := range entries {
+		if entry.IsDir() || !isYAMLFile(entry.Name()) {
+			continue
+		}
+
+		filePath := path.Join(dir, entry.Name())
+		scenario, err := loadScenarioFile(fsys, filePath, data)
+		if err != nil {
+			return nil, fmt.Errorf("loading scenario %q: %w", filePath, err)
+		}
+		out = append(out, scenario)
+	}
+
+	return out, nil
+}
+
+func loadScenarioFile(fsys fs.FS, path string, data templateData) (*Scenario, error) {
+	raw, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		return nil, fmt.Errorf("reading file: %w", err)
+	}
+
+	tmpl, err := template.New(path).Parse(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("parsing template: %w", err)
+	}
+
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, data); err != nil {
+		return nil, fmt.Errorf("rendering template: %w", err)
+	}
+
+	var file scenarioFile
+	if err := yaml.Unmarshal(rendered.Bytes(), &file); err != nil {
+		return nil, fmt.Errorf("unmarshalling yaml: %w", err)
+	}
+
+	if file.Name == "" {
+		return nil, fmt.Errorf("scenario is missing a name")
+	}
+
+	scenario := &Scenario{
+		Name:                     file.Name,
+		Description:              file.Description,
+		Labels:                   file.Labels,
+		Tags:                     file.Tags,
+		Distro:                   file.Distro,
+		Arch:                     file.Arch,
+		VHD:                      file.VHD,
+		BootstrapConfigOverrides: file.BootstrapConfigOverrides,
+	}
+	for _, step := range file.ValidationSteps {
+		scenario.LiveVMValidators = append(scenario.LiveVMValidators, &LiveVMValidator{
+			Description:         step.Description,
+			Command:             step.Command,
+			ExpectedExitCode:    step.ExpectedExitCode,
+			ExpectedStdoutRegex: step.ExpectedStdoutRegex,
+		})
+	}
+
+	return scenario, nil
+}
+
+func isYAMLFile(name string) bool {
+	ext := strings.ToLower(filepath.Ext(name))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+func environAsMap() map[string]string {
+	env := map[string]string{}
+	for _, kv := range os.Environ() {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) == 2 {
+			env[parts[0]] = parts[1]
+		}
+	}
+	return env
+}
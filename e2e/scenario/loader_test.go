@@ -0,0 +1,69 @@
+package scenario
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadScenariosFromDir(t *testing.T) {
+	fsys := fstest.MapFS{
+		"scenarios/ubuntu.yaml": &fstest.MapFile{Data: []byte(`
+name: ubuntu2204-{{ .Vars.suffix }}
+description: templated scenario
+labels:
+  os: ubuntu2204
+  region: "{{ .Vars.region }}"
+tags:
+  - gpu
+distro: ubuntu2204
+arch: amd64
+vhd: "{{ .Env.TEST_VHD }}"
+validationSteps:
+  - description: check kubelet
+    command: systemctl is-active kubelet
+    expectedExitCode: 0
+    expectedStdoutRegex: "^active$"
+`)},
+		"scenarios/not-yaml.txt": &fstest.MapFile{Data: []byte("ignored")},
+	}
+
+	t.Setenv("TEST_VHD", "/subscriptions/fake/vhd")
+
+	scenarios, err := LoadScenariosFromDir(fsys, "scenarios", map[string]string{
+		"suffix": "arm64",
+		"region": "eastus",
+	})
+	if err != nil {
+		t.Fatalf("LoadScenariosFromDir returned error: %v", err)
+	}
+	if len(scenarios) != 1 {
+		t.Fatalf("expected 1 scenario (non-yaml file should be skipped), got %d", len(scenarios))
+	}
+
+	got := scenarios[0]
+	if got.Name != "ubuntu2204-arm64" {
+		t.Errorf("Name = %q, want %q", got.Name, "ubuntu2204-arm64")
+	}
+	if got.Labels["os"] != "ubuntu2204" || got.Labels["region"] != "eastus" {
+		t.Errorf("Labels = %v, want os=ubuntu2204 region=eastus", got.Labels)
+	}
+	if len(got.Tags) != 1 || got.Tags[0] != "gpu" {
+		t.Errorf("Tags = %v, want [gpu]", got.Tags)
+	}
+	if got.VHD != "/subscriptions/fake/vhd" {
+		t.Errorf("VHD = %q, want templated value from TEST_VHD", got.VHD)
+	}
+	if len(got.LiveVMValidators) != 1 || got.LiveVMValidators[0].Command != "systemctl is-active kubelet" {
+		t.Errorf("LiveVMValidators = %+v, want one validator for kubelet", got.LiveVMValidators)
+	}
+}
+
+func TestLoadScenariosFromDirMissingName(t *testing.T) {
+	fsys := fstest.MapFS{
+		"scenarios/bad.yaml": &fstest.MapFile{Data: []byte("description: no name here\n")},
+	}
+
+	if _, err := LoadScenariosFromDir(fsys, "scenarios", nil); err == nil {
+		t.Fatal("expected error for scenario file missing a name, got nil")
+	}
+}
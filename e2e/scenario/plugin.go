@@ -0,0 +1,69 @@
+//go:build linux && cgo
+
+package scenario
+
+import (
+	"fmt"
+	"path/filepath"
+	"plugin"
+)
+
+// PluginDirEnvVar points scenarios() at a glob of out-of-tree scenario plugins that
+// supplement the hardcoded and YAML-loaded scenarios, e.g. "/path/to/*.so". Mirrors the
+// --scenario-plugins e2e CLI flag. Unset by default.
+const PluginDirEnvVar = "AGENTBAKER_E2E_SCENARIO_PLUGINS"
+
+// providerSymbolName is the exported symbol each plugin .so must define, of type ScenarioProvider.
+const providerSymbolName = "Provider"
+
+// ScenarioProvider is implemented by an out-of-tree plugin's exported Provider symbol to
+// contribute scenarios. A provider's Scenarios may reference VHDs and validators it adds
+// to this package's registries (RegisterVHD, RegisterValidator) from its init function, and
+// may set BootstrapConfigMutators on the scenarios it returns.
+type ScenarioProvider interface {
+	Scenarios() []*Scenario
+}
+
+// loadPlugins opens every .so file matching pattern and collects the scenarios contributed
+// by each one's exported Provider symbol.
+func loadPlugins(pattern string) ([]*Scenario, error) {
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("globbing scenario plugins %q: %w", pattern, err)
+	}
+
+	var out []*Scenario
+	for _, path := range paths {
+		provider, err := loadProvider(path)
+		if err != nil {
+			return nil, fmt.Errorf("loading scenario plugin %q: %w", path, err)
+		}
+		out = append(out, provider.Scenarios()...)
+	}
+
+	return out, nil
+}
+
+func loadProvider(path string) (ScenarioProvider, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening plugin: %w", err)
+	}
+
+	sym, err := p.Lookup(providerSymbolName)
+	if err != nil {
+		return nil, fmt.Errorf("looking up %q symbol: %w", providerSymbolName, err)
+	}
+
+	switch provider := sym.(type) {
+	case ScenarioProvider:
+		return provider, nil
+	case *ScenarioProvider:
+		// A plugin variable declared as `var Provider ScenarioProvider` is looked up as a pointer to it.
+		if *provider != nil {
+			return *provider, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%q symbol does not implement ScenarioProvider", providerSymbolName)
+}
@@ -0,0 +1,22 @@
+package scenario
+
+import "testing"
+
+func TestLoadPluginsNoMatches(t *testing.T) {
+	// A pattern that can't match any file should behave the same on every platform:
+	// no providers to load, so no error and no scenarios, even where plugin.Open itself
+	// isn't supported (see plugin_unsupported.go).
+	scenarios, err := loadPlugins("testdata/no-such-plugin-*.so")
+	if err != nil {
+		t.Fatalf("loadPlugins returned error for a non-matching pattern: %v", err)
+	}
+	if len(scenarios) != 0 {
+		t.Errorf("loadPlugins = %v, want no scenarios", scenarios)
+	}
+}
+
+func TestLoadPluginsInvalidPattern(t *testing.T) {
+	if _, err := loadPlugins("["); err == nil {
+		t.Fatal("loadPlugins: expected error for malformed glob pattern")
+	}
+}
@@ -0,0 +1,27 @@
+//go:build !(linux && cgo)
+
+package scenario
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// PluginDirEnvVar mirrors the definition in plugin.go; kept here so scenarios() can refer
+// to it unconditionally regardless of plugin support on the build platform.
+const PluginDirEnvVar = "AGENTBAKER_E2E_SCENARIO_PLUGINS"
+
+// loadPlugins globs pattern like plugin.go does, but errors if it actually matches anything,
+// since Go's plugin package isn't supported on this platform (anything other than linux with
+// cgo enabled). A pattern matching nothing is not an error: there's nothing to load.
+func loadPlugins(pattern string) ([]*Scenario, error) {
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("globbing scenario plugins %q: %w", pattern, err)
+	}
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf("scenario plugins are not supported on this platform (requires linux+cgo): found %d matching %q", len(paths), pattern)
+}
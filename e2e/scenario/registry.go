@@ -0,0 +1,67 @@
+package scenario
+
+import "sync"
+
+// BootstrapConfigMutator mutates a scenario's bootstrap config overrides before a VM is
+// created for it. Plugins register these against a scenario by appending to its
+// BootstrapConfigMutators field.
+type BootstrapConfigMutator func(overrides map[string]interface{})
+
+// ResolvedBootstrapConfig returns s.BootstrapConfigOverrides with every mutator in
+// s.BootstrapConfigMutators applied, in order, on top of it.
+func (s *Scenario) ResolvedBootstrapConfig() map[string]interface{} {
+	overrides := map[string]interface{}{}
+	for k, v := range s.BootstrapConfigOverrides {
+		overrides[k] = v
+	}
+	for _, mutate := range s.BootstrapConfigMutators {
+		mutate(overrides)
+	}
+	return overrides
+}
+
+var (
+	vhdCatalogMu sync.RWMutex
+	vhdCatalog   = map[string]string{}
+
+	validatorRegistryMu sync.RWMutex
+	validatorRegistry   = map[string]func() *LiveVMValidator{}
+)
+
+// RegisterVHD adds name to the VHD catalog, resolving to ref (a gallery image ID or URL).
+// Plugins call this to contribute image references that can't live in this repo, e.g. a
+// confidential-containers or FIPS VHD.
+func RegisterVHD(name, ref string) {
+	vhdCatalogMu.Lock()
+	defer vhdCatalogMu.Unlock()
+	vhdCatalog[name] = ref
+}
+
+// ResolveVHD looks up name in the VHD catalog populated by RegisterVHD.
+func ResolveVHD(name string) (string, bool) {
+	vhdCatalogMu.RLock()
+	defer vhdCatalogMu.RUnlock()
+	ref, ok := vhdCatalog[name]
+	return ref, ok
+}
+
+// RegisterValidator adds name to the validator registry, so Go code (including plugins)
+// can look a LiveVMValidator up by name with Validator instead of duplicating its
+// command/expectations inline. YAML scenario files cannot refer to a registered validator;
+// their validation steps are always written inline.
+func RegisterValidator(name string, newValidator func() *LiveVMValidator) {
+	validatorRegistryMu.Lock()
+	defer validatorRegistryMu.Unlock()
+	validatorRegistry[name] = newValidator
+}
+
+// Validator looks up name in the validator registry populated by RegisterValidator.
+func Validator(name string) (*LiveVMValidator, bool) {
+	validatorRegistryMu.RLock()
+	newValidator, ok := validatorRegistry[name]
+	validatorRegistryMu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return newValidator(), true
+}
@@ -0,0 +1,59 @@
+package scenario
+
+import "testing"
+
+func TestRegisterAndResolveVHD(t *testing.T) {
+	RegisterVHD("fips-ubuntu2204", "/subscriptions/fake/fips-vhd")
+
+	ref, ok := ResolveVHD("fips-ubuntu2204")
+	if !ok {
+		t.Fatal("ResolveVHD: expected registered VHD to be found")
+	}
+	if ref != "/subscriptions/fake/fips-vhd" {
+		t.Errorf("ResolveVHD = %q, want %q", ref, "/subscriptions/fake/fips-vhd")
+	}
+
+	if _, ok := ResolveVHD("does-not-exist"); ok {
+		t.Error("ResolveVHD: expected unregistered name to be not found")
+	}
+}
+
+func TestRegisterAndLookUpValidator(t *testing.T) {
+	RegisterValidator("kubelet-active", func() *LiveVMValidator {
+		return &LiveVMValidator{Command: "systemctl is-active kubelet", ExpectedExitCode: 0}
+	})
+
+	v, ok := Validator("kubelet-active")
+	if !ok {
+		t.Fatal("Validator: expected registered validator to be found")
+	}
+	if v.Command != "systemctl is-active kubelet" {
+		t.Errorf("Command = %q, want %q", v.Command, "systemctl is-active kubelet")
+	}
+
+	if _, ok := Validator("does-not-exist"); ok {
+		t.Error("Validator: expected unregistered name to be not found")
+	}
+}
+
+func TestScenarioResolvedBootstrapConfig(t *testing.T) {
+	s := &Scenario{
+		BootstrapConfigOverrides: map[string]interface{}{"kubeletFlags": "a"},
+		BootstrapConfigMutators: []BootstrapConfigMutator{
+			func(overrides map[string]interface{}) { overrides["kubeletFlags"] = "b" },
+			func(overrides map[string]interface{}) { overrides["extra"] = "c" },
+		},
+	}
+
+	resolved := s.ResolvedBootstrapConfig()
+	if resolved["kubeletFlags"] != "b" {
+		t.Errorf("kubeletFlags = %v, want mutator to override to %q", resolved["kubeletFlags"], "b")
+	}
+	if resolved["extra"] != "c" {
+		t.Errorf("extra = %v, want %q", resolved["extra"], "c")
+	}
+
+	if s.BootstrapConfigOverrides["kubeletFlags"] != "a" {
+		t.Error("ResolvedBootstrapConfig must not mutate the scenario's original overrides map")
+	}
+}
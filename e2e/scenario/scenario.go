@@ -0,0 +1,42 @@
+package scenario
+
+// Scenario describes a single E2E test case: the VHD/image it targets, the
+// node bootstrapping config overrides it applies, and the steps used to
+// validate the resulting node once it's live.
+type Scenario struct {
+	Name        string
+	Description string
+
+	// Labels are key/value attributes matched by Selector expressions, e.g. os=ubuntu2204.
+	Labels map[string]string
+	// Tags are boolean attributes matched by Selector expressions, e.g. gpu.
+	Tags []string
+
+	// Distro and Arch identify the target image for this scenario, e.g. "ubuntu2204" / "amd64".
+	Distro string
+	Arch   string
+	// VHD is the image reference (gallery image or URL) this scenario bootstraps against.
+	VHD string
+
+	// BootstrapConfigOverrides are applied on top of the default node bootstrapping config
+	// for this scenario, keyed by field name.
+	BootstrapConfigOverrides map[string]interface{}
+	// BootstrapConfigMutators run, in order, on top of BootstrapConfigOverrides; see
+	// ResolvedBootstrapConfig.
+	BootstrapConfigMutators []BootstrapConfigMutator
+
+	// LiveVMValidators are run against the node once it has booted.
+	LiveVMValidators []*LiveVMValidator
+}
+
+// LiveVMValidator is a single validation step run on the live VM: a command along with
+// the exit code and stdout pattern expected of it.
+type LiveVMValidator struct {
+	Description         string
+	Command             string
+	ExpectedExitCode    int
+	ExpectedStdoutRegex string
+}
+
+// ScenarioTable indexes scenarios by name for quick lookup during a test run.
+type ScenarioTable map[string]*Scenario
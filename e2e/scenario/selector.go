@@ -0,0 +1,97 @@
+package scenario
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Selector is a parsed boolean expression over a Scenario's Labels and Tags. All terms
+// in a Selector are ANDed together.
+type Selector struct {
+	terms []selectorTerm
+}
+
+type selectorTerm struct {
+	negate bool
+	key    string // set for a label-equality term, e.g. "os" in "os=ubuntu2204"
+	value  string // the expected value for a label-equality term
+	tag    string // set for a tag term, e.g. "gpu" in "gpu", or "mariner" in "!mariner"
+}
+
+// ParseSelector parses a boolean expression over scenario labels/tags. Terms may be
+// separated by "&&" or ",", and are ANDed together, e.g.:
+//
+//	os=ubuntu2204 && arch=arm64   -- label "os" is "ubuntu2204" AND label "arch" is "arm64"
+//	gpu                           -- tag "gpu" is present
+//	!mariner                      -- tag "mariner" is absent
+func ParseSelector(expr string) (Selector, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return Selector{}, nil
+	}
+
+	parts := strings.Split(strings.ReplaceAll(expr, "&&", ","), ",")
+	var sel Selector
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		var term selectorTerm
+		if strings.HasPrefix(part, "!") {
+			term.negate = true
+			part = part[1:]
+		}
+
+		if key, value, found := strings.Cut(part, "="); found {
+			term.key, term.value = strings.TrimSpace(key), strings.TrimSpace(value)
+		} else {
+			term.tag = part
+		}
+
+		if term.key == "" && term.tag == "" {
+			return Selector{}, fmt.Errorf("invalid selector term %q in %q", part, expr)
+		}
+		sel.terms = append(sel.terms, term)
+	}
+
+	return sel, nil
+}
+
+// Matches reports whether scenario satisfies every term in the selector. An empty
+// Selector matches every scenario.
+func (s Selector) Matches(scenario *Scenario) bool {
+	for _, term := range s.terms {
+		var ok bool
+		if term.tag != "" {
+			ok = hasTag(scenario.Tags, term.tag)
+		} else {
+			ok = scenario.Labels[term.key] == term.value
+		}
+		if ok == term.negate {
+			return false
+		}
+	}
+	return true
+}
+
+func hasTag(tags []string, name string) bool {
+	for _, t := range tags {
+		if t == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter returns the subset of the table whose scenarios match selector.
+func (t ScenarioTable) Filter(selector Selector) ScenarioTable {
+	out := ScenarioTable{}
+	for name, scenario := range t {
+		if selector.Matches(scenario) {
+			out[name] = scenario
+		}
+	}
+	return out
+}
@@ -0,0 +1,67 @@
+package scenario
+
+import "testing"
+
+func TestParseSelectorAndMatches(t *testing.T) {
+	scenario := &Scenario{
+		Name:   "ubuntu2204-arm64-gpu",
+		Labels: map[string]string{"os": "ubuntu2204", "arch": "arm64"},
+		Tags:   []string{"gpu"},
+	}
+
+	tests := []struct {
+		name    string
+		expr    string
+		want    bool
+		wantErr bool
+	}{
+		{name: "empty selector matches everything", expr: "", want: true},
+		{name: "single label match", expr: "os=ubuntu2204", want: true},
+		{name: "single label mismatch", expr: "os=mariner", want: false},
+		{name: "and of labels with &&", expr: "os=ubuntu2204 && arch=arm64", want: true},
+		{name: "and of labels with comma", expr: "os=ubuntu2204,arch=arm64", want: true},
+		{name: "one of the anded labels mismatches", expr: "os=ubuntu2204 && arch=amd64", want: false},
+		{name: "tag present", expr: "gpu", want: true},
+		{name: "tag absent", expr: "fips", want: false},
+		{name: "negated tag absent matches", expr: "!mariner", want: true},
+		{name: "negated tag present does not match", expr: "!gpu", want: false},
+		{name: "mix of label and tag terms", expr: "os=ubuntu2204,gpu,!mariner", want: true},
+		{name: "invalid term", expr: "=ubuntu2204", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sel, err := ParseSelector(tt.expr)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseSelector(%q) expected error, got nil", tt.expr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseSelector(%q) returned error: %v", tt.expr, err)
+			}
+
+			if got := sel.Matches(scenario); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScenarioTableFilter(t *testing.T) {
+	table := ScenarioTable{
+		"a": {Name: "a", Tags: []string{"gpu"}},
+		"b": {Name: "b", Tags: []string{"mariner"}},
+	}
+
+	sel, err := ParseSelector("gpu")
+	if err != nil {
+		t.Fatalf("ParseSelector returned error: %v", err)
+	}
+
+	filtered := table.Filter(sel)
+	if len(filtered) != 1 || filtered["a"] == nil {
+		t.Errorf("Filter() = %v, want only scenario %q", filtered, "a")
+	}
+}